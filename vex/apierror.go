@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 The Vex Authors.
+//
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+// Licensed under the Apache License, Version 2.0 <LICENSE-APACHE or
+// http://www.apache.org/licenses/LICENSE-2.0> or the MIT license
+// <LICENSE-MIT or http://opensource.org/licenses/MIT>, at your
+// option. You may not use this file except in compliance with the
+// terms of those licenses.
+
+package vex
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// APIError is the error payload returned by every Vex endpoint.
+//
+// Clients receive it wrapped in an envelope object under the "error" key:
+//
+//	{
+//	  "error": {
+//	    "code": "invalid_request",
+//	    "message": "limit must be a positive integer",
+//	    "details": ["limit: got \"-1\""]
+//	  }
+//	}
+//
+// Code is a stable, machine-readable identifier that SDKs can switch on.
+// Message is a human-readable description and may change between releases.
+// Details is optional and omitted when empty.
+type APIError struct {
+	Code    string   `json:"code"`
+	Message string   `json:"message"`
+	Details []string `json:"details,omitempty"`
+}
+
+// Error implements the error interface.
+func (e APIError) Error() string {
+	return e.Code + ": " + e.Message
+}
+
+// apiErrorEnvelope is the top-level JSON object written by [WriteError].
+type apiErrorEnvelope struct {
+	Error APIError `json:"error"`
+}
+
+// WriteError writes err to w as a JSON error envelope with the given HTTP
+// status code.
+func WriteError(w http.ResponseWriter, status int, err APIError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	// The status line is already written, so there is nothing useful to do
+	// with an encoding error here.
+	_ = json.NewEncoder(w).Encode(apiErrorEnvelope{Error: err})
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 The Vex Authors.
+//
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+// Licensed under the Apache License, Version 2.0 <LICENSE-APACHE or
+// http://www.apache.org/licenses/LICENSE-2.0> or the MIT license
+// <LICENSE-MIT or http://opensource.org/licenses/MIT>, at your
+// option. You may not use this file except in compliance with the
+// terms of those licenses.
+
+package vex
+
+import "net/http"
+
+// Middleware wraps an [http.Handler] with additional behavior.
+type Middleware = func(http.Handler) http.Handler
+
+// Chain composes middlewares into a single [Middleware].
+//
+// Middlewares are applied outermost-first: the first one sees the request
+// first and the response last. For example,
+//
+//	Chain(auth, logging)(h)
+//
+// is equivalent to auth(logging(h)), so auth runs before logging, which runs
+// before h. Chain with no arguments returns the handler unchanged.
+func Chain(middlewares ...Middleware) Middleware {
+	return func(next http.Handler) http.Handler {
+		for i := len(middlewares) - 1; i >= 0; i-- {
+			next = middlewares[i](next)
+		}
+
+		return next
+	}
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 The Vex Authors.
+//
+// SPDX-License-Identifier: Apache-2.0 OR MIT
+//
+// Licensed under the Apache License, Version 2.0 <LICENSE-APACHE or
+// http://www.apache.org/licenses/LICENSE-2.0> or the MIT license
+// <LICENSE-MIT or http://opensource.org/licenses/MIT>, at your
+// option. You may not use this file except in compliance with the
+// terms of those licenses.
+
+package vex
+
+import (
+	"cmp"
+	"strconv"
+)
+
+// Vex version components, following semantic versioning.
+const (
+	VersionMajor = 0
+	VersionMinor = 0
+	VersionPatch = 1
+)
+
+// SemVer is a comparable semantic version.
+type SemVer struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// String returns v in "MAJOR.MINOR.PATCH" form.
+func (v SemVer) String() string {
+	return strconv.Itoa(v.Major) + "." + strconv.Itoa(v.Minor) + "." + strconv.Itoa(v.Patch)
+}
+
+// Compare returns -1 if v is lower than other, 0 if they are equal, and +1 if
+// v is higher than other.
+func (v SemVer) Compare(other SemVer) int {
+	switch {
+	case v.Major != other.Major:
+		return cmp.Compare(v.Major, other.Major)
+	case v.Minor != other.Minor:
+		return cmp.Compare(v.Minor, other.Minor)
+	default:
+		return cmp.Compare(v.Patch, other.Patch)
+	}
+}
+
+// VersionInfo returns the version of this Vex build.
+func VersionInfo() SemVer {
+	return SemVer{Major: VersionMajor, Minor: VersionMinor, Patch: VersionPatch}
+}
+
+// Version returns the version of this Vex build in "MAJOR.MINOR.PATCH" form.
+func Version() string {
+	return VersionInfo().String()
+}